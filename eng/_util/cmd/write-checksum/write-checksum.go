@@ -20,11 +20,14 @@ This command creates a SHA256 checksum file for the given files, in the same
 location and with the same name as each given file but with ".sha256" added to
 the end. Pass files as non-flag arguments.
 
-Generated files are compatible with "sha256sum -c".
+By default, generated files use the GNU format and are compatible with
+"sha256sum -c". Pass "-checksum-format bsd" to write the BSD tag format instead,
+"SHA256 (file) = hash", which is compatible with "shasum -c" and BSD "sha256 -c".
 `
 
 func main() {
 	help := flag.Bool("h", false, "Print this help message.")
+	format := flag.String("checksum-format", "gnu", "The checksum file format: gnu or bsd.")
 
 	flag.Usage = func() {
 		fmt.Fprintf(flag.CommandLine.Output(), "Usage:\n")
@@ -41,14 +44,18 @@ func main() {
 		flag.Usage()
 		log.Fatal("No files specified.")
 	}
+	if *format != "gnu" && *format != "bsd" {
+		flag.Usage()
+		log.Fatalf("Unknown checksum format %q.", *format)
+	}
 	for _, m := range flag.Args() {
-		if err := writeSHA256ChecksumFile(m); err != nil {
+		if err := writeSHA256ChecksumFile(m, *format); err != nil {
 			log.Fatal(err)
 		}
 	}
 }
 
-func writeSHA256ChecksumFile(path string) error {
+func writeSHA256ChecksumFile(path, format string) error {
 	file, err := os.Open(path)
 	if err != nil {
 		return err
@@ -58,10 +65,11 @@ func writeSHA256ChecksumFile(path string) error {
 	if _, err = io.Copy(checksum, file); err != nil {
 		return err
 	}
-	// Write the checksum in a format that "sha256sum -c" can work with. Use the base path of the
-	// tarball (not full path, not relative path) because then "sha256sum -c" automatically works
-	// when the file and the checksum file are downloaded to the same directory.
-	content := fmt.Sprintf("%v  %v\n", hex.EncodeToString(checksum.Sum(nil)), filepath.Base(path))
+	// Write the checksum in a format that "sha256sum -c" (or for BSD format, "shasum -c") can work
+	// with. Use the base path of the tarball (not full path, not relative path) because then the
+	// check automatically works when the file and the checksum file are downloaded to the same
+	// directory.
+	content := formatChecksum(format, hex.EncodeToString(checksum.Sum(nil)), filepath.Base(path))
 	outputPath := path + ".sha256"
 	if err := os.WriteFile(outputPath, []byte(content), 0o666); err != nil {
 		return err
@@ -69,3 +77,11 @@ func writeSHA256ChecksumFile(path string) error {
 	fmt.Printf("Wrote checksum file %q with content: %v", outputPath, content)
 	return nil
 }
+
+// formatChecksum returns a checksum file line for the given file name in GNU or BSD tag format.
+func formatChecksum(format, hash, name string) string {
+	if format == "bsd" {
+		return fmt.Sprintf("SHA256 (%v) = %v\n", name, hash)
+	}
+	return fmt.Sprintf("%v  %v\n", hash, name)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Microsoft Corporation.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+// helloHash is the SHA256 of "hello\n".
+const helloHash = "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"
+
+func TestWriteSHA256ChecksumFile(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"gnu", helloHash + "  go.tar.gz\n"},
+		{"bsd", "SHA256 (go.tar.gz) = " + helloHash + "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "go.tar.gz")
+			if err := os.WriteFile(path, []byte("hello\n"), 0o666); err != nil {
+				t.Fatal(err)
+			}
+			if err := writeSHA256ChecksumFile(path, tt.format); err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(path + ".sha256")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatChecksumBSDParses(t *testing.T) {
+	// This is the line format that "shasum -c" and BSD "sha256 -c" accept.
+	bsdLine := regexp.MustCompile(`^SHA256 \((.+)\) = ([0-9a-f]{64})\n$`)
+	m := bsdLine.FindStringSubmatch(formatChecksum("bsd", helloHash, "go1.21.linux-amd64.tar.gz"))
+	if m == nil {
+		t.Fatal("BSD checksum line does not match the expected format")
+	}
+	if m[1] != "go1.21.linux-amd64.tar.gz" || m[2] != helloHash {
+		t.Errorf("parsed name %q and hash %q, want %q and %q", m[1], m[2], "go1.21.linux-amd64.tar.gz", helloHash)
+	}
+}